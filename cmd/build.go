@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/JoyenBenitto/giggle/feed"
+	"github.com/JoyenBenitto/giggle/render"
+	"github.com/JoyenBenitto/giggle/site"
+	"github.com/JoyenBenitto/giggle/theme"
+)
+
+var content_dir string
+
+var buildCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Build the site into build_dir",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := os.MkdirAll(build_dir, os.ModePerm); err != nil {
+			return err
+		}
+
+		return runBuild()
+	},
+}
+
+// runBuild performs one full build: render every content page through the
+// theme's chosen engine, copy the theme's static assets, then regenerate
+// the feeds from the resulting page list. It is shared between
+// `giggle build` and the rebuild loop in `giggle serve`.
+func runBuild() error {
+	config_info, err := yaml_reader(config_yaml)
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	th, err := theme.Load(theme_yaml)
+	if err != nil {
+		return fmt.Errorf("load theme: %w", err)
+	}
+
+	templateFiles, err := th.Templates()
+	if err != nil {
+		return err
+	}
+	renderer, err := render.New(th.Engine, templateFiles)
+	if err != nil {
+		return fmt.Errorf("build renderer: %w", err)
+	}
+
+	generator := site.NewPageGenerator(renderer, content_dir, build_dir)
+	if err := generator.Build(config_info); err != nil {
+		return err
+	}
+
+	if err := copyStaticAssets(th, build_dir); err != nil {
+		return err
+	}
+
+	return feed.Generate(generator.Pages, config_info, build_dir)
+}
+
+func copyStaticAssets(th *theme.Theme, buildDir string) error {
+	static, err := th.Static()
+	if err != nil {
+		return err
+	}
+
+	for rel, content := range static {
+		dest := filepath.Join(buildDir, "static", rel)
+		if err := os.MkdirAll(filepath.Dir(dest), os.ModePerm); err != nil {
+			return fmt.Errorf("create static dir for %q: %w", rel, err)
+		}
+		if err := os.WriteFile(dest, content, 0644); err != nil {
+			return fmt.Errorf("write static asset %q: %w", rel, err)
+		}
+	}
+	return nil
+}
+
+func init() {
+	buildCmd.Flags().StringVar(&content_dir, "content_dir", "./content", "Path to the content directory")
+	rootCmd.AddCommand(buildCmd)
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/spf13/cobra"
+)
+
+var deployCmd = &cobra.Command{
+	Use:   "deploy",
+	Short: "Deploy build_dir using the command configured under config_yaml's deploy section",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config_info, err := yaml_reader(config_yaml)
+		if err != nil {
+			return fmt.Errorf("load config: %w", err)
+		}
+
+		deploy, ok := config_info["deploy"].(map[interface{}]interface{})
+		if !ok {
+			return fmt.Errorf("no deploy section found in %q", config_yaml)
+		}
+
+		command, ok := deploy["command"].(string)
+		if !ok || command == "" {
+			return fmt.Errorf("deploy.command missing in %q", config_yaml)
+		}
+
+		fmt.Println("Running deploy command:", command)
+		deployCommand := exec.Command("sh", "-c", command)
+		deployCommand.Dir = build_dir
+		output, err := deployCommand.CombinedOutput()
+		fmt.Print(string(output))
+		if err != nil {
+			return fmt.Errorf("deploy command failed: %w", err)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(deployCmd)
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init <name>",
+	Short: "Scaffold a new giggle site",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+
+		err := fs.WalkDir(scaffoldFS, "scaffold", func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			rel, err := filepath.Rel("scaffold", path)
+			if err != nil {
+				return err
+			}
+			target := filepath.Join(name, rel)
+
+			if d.IsDir() {
+				return os.MkdirAll(target, os.ModePerm)
+			}
+
+			data, err := scaffoldFS.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("read scaffold file %q: %w", path, err)
+			}
+			return os.WriteFile(target, data, 0644)
+		})
+		if err != nil {
+			return fmt.Errorf("scaffold site %q: %w", name, err)
+		}
+
+		fmt.Println("Created new site in", name)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(initCmd)
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var newCmd = &cobra.Command{
+	Use:   "new",
+	Short: "Create new site content",
+}
+
+var newPostCmd = &cobra.Command{
+	Use:   "post <title>",
+	Short: "Create a new markdown post with a front-matter stub",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		title := args[0]
+		slug := slugify(title)
+		path := filepath.Join(content_dir, slug+".md")
+
+		stub := fmt.Sprintf(`---
+title: %q
+date: %s
+draft: true
+---
+
+`, title, time.Now().Format("2006-01-02"))
+
+		if err := os.MkdirAll(content_dir, os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(stub), 0644); err != nil {
+			return fmt.Errorf("write %q: %w", path, err)
+		}
+
+		fmt.Println("Created", path)
+		return nil
+	},
+}
+
+var slugInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify turns a post title into a filesystem- and URL-safe slug, e.g.
+// "Hello, World!" -> "hello-world".
+func slugify(title string) string {
+	slug := slugInvalidChars.ReplaceAllString(strings.ToLower(title), "-")
+	return strings.Trim(slug, "-")
+}
+
+func init() {
+	newPostCmd.Flags().StringVar(&content_dir, "content_dir", "./content", "Path to the content directory")
+	newCmd.AddCommand(newPostCmd)
+	rootCmd.AddCommand(newCmd)
+}
@@ -0,0 +1,29 @@
+// Package cmd holds the giggle CLI: a cobra root command with init, new,
+// build, serve and deploy subcommands.
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	config_yaml string
+	theme_yaml  string
+	build_dir   string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "giggle",
+	Short: "giggle is a static site generator",
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&config_yaml, "config_yaml", "./config.yaml", "Path to the config yaml")
+	rootCmd.PersistentFlags().StringVar(&theme_yaml, "theme_yaml", "./theme", "Path to the theme directory, or empty for the embedded default theme")
+	rootCmd.PersistentFlags().StringVar(&build_dir, "build_dir", "./build", "Path to the build directory")
+}
+
+// Execute runs the giggle CLI. It is the sole entry point main calls.
+func Execute() error {
+	return rootCmd.Execute()
+}
@@ -0,0 +1,9 @@
+package cmd
+
+import "embed"
+
+// scaffoldFS is the default site scaffold compiled into the binary so that
+// `giggle init` works from a single binary with nothing else on disk.
+//
+//go:embed all:scaffold
+var scaffoldFS embed.FS
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/JoyenBenitto/giggle/devserver"
+)
+
+var serve_addr string
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve build_dir over HTTP with live reload for local development",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := os.MkdirAll(build_dir, os.ModePerm); err != nil {
+			return err
+		}
+
+		server := devserver.New(
+			serve_addr,
+			build_dir,
+			[]string{content_dir, theme_yaml},
+			[]string{config_yaml},
+			runBuild,
+		)
+
+		return server.Run()
+	},
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serve_addr, "addr", "127.0.0.1:1313", "Address to serve on")
+	serveCmd.Flags().StringVar(&content_dir, "content_dir", "./content", "Path to the content directory")
+	rootCmd.AddCommand(serveCmd)
+}
@@ -0,0 +1,30 @@
+// The following folder consists of utility functions
+
+/*
+Functions in the file:
+* yaml_reader: reads a YAML file and returns its top-level keys as a map, or an error
+*/
+
+package cmd
+
+import (
+    "fmt"
+    "io/ioutil"
+    "gopkg.in/yaml.v2"
+)
+
+func yaml_reader(file_path string) (map[string]interface{}, error) {
+    /*
+    The yaml reader
+    */
+    obj := make(map[string]interface{})
+    yamlFile, err := ioutil.ReadFile(file_path)
+    if err != nil {
+        return nil, fmt.Errorf("read %q: %w", file_path, err)
+    }
+
+    if err := yaml.Unmarshal(yamlFile, obj); err != nil {
+        return nil, fmt.Errorf("parse %q: %w", file_path, err)
+    }
+    return obj, nil
+}
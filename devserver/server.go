@@ -0,0 +1,263 @@
+// Package devserver implements the local dev server behind `giggle serve`:
+// it serves a build directory over HTTP, rebuilds on filesystem changes and
+// pushes a live-reload signal to connected browsers over a websocket.
+package devserver
+
+import (
+	"fmt"
+	"html"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/gorilla/websocket"
+)
+
+// debounceInterval coalesces bursts of filesystem events (e.g. an editor
+// writing a file via a temp-file-then-rename) into a single rebuild.
+const debounceInterval = 100 * time.Millisecond
+
+// BuildFunc performs one full site build. Errors are surfaced to the
+// browser as a structured error page instead of crashing the server.
+type BuildFunc func() error
+
+// Server serves BuildDir, watches WatchDirs/WatchFiles for changes and
+// rebuilds via Build on every change, live-reloading any open browser tabs.
+type Server struct {
+	Addr       string
+	BuildDir   string
+	WatchDirs  []string
+	WatchFiles []string
+	Build      BuildFunc
+
+	upgrader  websocket.Upgrader
+	clientsMu sync.Mutex
+	clients   map[*websocket.Conn]bool
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+// New constructs a Server ready to Run.
+func New(addr, buildDir string, watchDirs, watchFiles []string, build BuildFunc) *Server {
+	return &Server{
+		Addr:       addr,
+		BuildDir:   buildDir,
+		WatchDirs:  watchDirs,
+		WatchFiles: watchFiles,
+		Build:      build,
+		clients:    make(map[*websocket.Conn]bool),
+		upgrader:   websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }},
+	}
+}
+
+// Run performs an initial build, starts watching for changes and blocks
+// serving HTTP until the process is killed or ListenAndServe errors.
+func (s *Server) Run() error {
+	s.rebuild()
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("create watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := s.addWatches(watcher); err != nil {
+		return err
+	}
+	go s.watchLoop(watcher)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/__livereload", s.handleWebSocket)
+	mux.Handle("/", s.handleHTTP())
+
+	log.Println("serving", s.BuildDir, "on", s.Addr)
+	return http.ListenAndServe(s.Addr, mux)
+}
+
+func (s *Server) addWatches(watcher *fsnotify.Watcher) error {
+	for _, dir := range s.WatchDirs {
+		if dir == "" {
+			// e.g. theme_yaml left empty to use the embedded default theme.
+			continue
+		}
+		err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				// Missing directories (e.g. no static/ yet) shouldn't stop the server.
+				if os.IsNotExist(err) {
+					return filepath.SkipDir
+				}
+				return err
+			}
+			if d.IsDir() {
+				return watcher.Add(path)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("watch %q: %w", dir, err)
+		}
+	}
+
+	for _, file := range s.WatchFiles {
+		if _, err := os.Stat(file); err != nil {
+			continue
+		}
+		if err := watcher.Add(file); err != nil {
+			return fmt.Errorf("watch %q: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) watchLoop(watcher *fsnotify.Watcher) {
+	var timer *time.Timer
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.AfterFunc(debounceInterval, func() {
+				s.rebuild()
+				s.notifyClients()
+			})
+			_ = event
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Println("watch error:", err)
+		}
+	}
+}
+
+func (s *Server) rebuild() {
+	start := time.Now()
+	err := s.Build()
+
+	s.mu.Lock()
+	s.lastErr = err
+	s.mu.Unlock()
+
+	if err != nil {
+		log.Println("build failed:", err)
+		return
+	}
+	log.Printf("rebuilt in %s", time.Since(start))
+}
+
+func (s *Server) handleHTTP() http.Handler {
+	fileServer := http.FileServer(http.Dir(s.BuildDir))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		lastErr := s.lastErr
+		s.mu.Unlock()
+
+		if lastErr != nil {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, errorPageHTML, html.EscapeString(lastErr.Error()), liveReloadScript)
+			return
+		}
+
+		if s.serveWithLiveReload(w, r) {
+			return
+		}
+		fileServer.ServeHTTP(w, r)
+	})
+}
+
+// serveWithLiveReload serves an HTML page with the live-reload snippet
+// injected before </body>. It returns false (leaving the request to the
+// plain file server) for anything that isn't an HTML page.
+func (s *Server) serveWithLiveReload(w http.ResponseWriter, r *http.Request) bool {
+	path := filepath.Join(s.BuildDir, filepath.Clean(r.URL.Path))
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, "index.html")
+	}
+	if !strings.HasSuffix(path, ".html") {
+		return false
+	}
+
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	page := string(body)
+	if idx := strings.LastIndex(page, "</body>"); idx >= 0 {
+		page = page[:idx] + liveReloadScript + page[idx:]
+	} else {
+		page += liveReloadScript
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, page)
+	return true
+}
+
+func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Println("livereload upgrade failed:", err)
+		return
+	}
+
+	s.clientsMu.Lock()
+	s.clients[conn] = true
+	s.clientsMu.Unlock()
+
+	defer func() {
+		s.clientsMu.Lock()
+		delete(s.clients, conn)
+		s.clientsMu.Unlock()
+		conn.Close()
+	}()
+
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) notifyClients() {
+	s.clientsMu.Lock()
+	defer s.clientsMu.Unlock()
+
+	for conn := range s.clients {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte("reload")); err != nil {
+			conn.Close()
+			delete(s.clients, conn)
+		}
+	}
+}
+
+const liveReloadScript = `<script>(function(){
+	var ws = new WebSocket("ws://" + location.host + "/__livereload");
+	ws.onmessage = function(){ location.reload(); };
+	ws.onclose = function(){ setTimeout(function(){ location.reload(); }, 1000); };
+})();</script>`
+
+const errorPageHTML = `<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>giggle build error</title></head>
+<body>
+	<h1>Build failed</h1>
+	<pre>%s</pre>
+	%s
+</body>
+</html>`
@@ -0,0 +1,281 @@
+// Package feed turns the []site.Page slice produced by a build into
+// build/atom.xml, build/rss.xml and build/sitemap.xml.
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JoyenBenitto/giggle/site"
+)
+
+// Config carries the site-wide metadata (from config_yaml) that every feed
+// entry shares, as opposed to per-entry fields which come from each page's
+// front matter.
+type Config struct {
+	Title    string
+	BaseURL  string
+	Author   string
+	Language string
+	// DefaultLanguage matches site.PageGenerator's routing: that language's
+	// feeds and sitemap keep the unsuffixed atom.xml/rss.xml/sitemap.xml
+	// names, every other language gets a ".<lang>" suffix.
+	DefaultLanguage string
+	// Limit caps how many entries atom.xml/rss.xml carry. 0 means unlimited.
+	Limit int
+}
+
+// ConfigFromYAML reads site-wide feed metadata out of config_info, falling
+// back to sensible defaults for anything missing.
+func ConfigFromYAML(config_info map[string]interface{}) Config {
+	cfg := Config{Title: "My Site", Language: "en", DefaultLanguage: "en", Limit: 20}
+
+	if v, ok := config_info["title"].(string); ok {
+		cfg.Title = v
+	}
+	if v, ok := config_info["base_url"].(string); ok {
+		cfg.BaseURL = v
+	}
+	if v, ok := config_info["author"].(string); ok {
+		cfg.Author = v
+	}
+	if v, ok := config_info["language"].(string); ok {
+		cfg.Language = v
+	}
+	if v, ok := config_info["default_language"].(string); ok && v != "" {
+		cfg.DefaultLanguage = v
+	}
+
+	if section, ok := config_info["feed"].(map[interface{}]interface{}); ok {
+		if v, ok := section["limit"].(int); ok {
+			cfg.Limit = v
+		}
+	}
+
+	return cfg
+}
+
+// Generate writes atom.xml, rss.xml and sitemap.xml for pages into
+// buildDir, one set per language. The site's DefaultLanguage keeps the
+// unsuffixed names; every other language's feeds and sitemap get a
+// ".<lang>" suffix, e.g. atom.de.xml. Pages whose front matter sets
+// `feed: false` are left out of the feeds and the sitemaps.
+func Generate(pages []site.Page, config_info map[string]interface{}, buildDir string) error {
+	cfg := ConfigFromYAML(config_info)
+
+	byLang := make(map[string][]site.Page)
+	for _, page := range eligiblePages(pages) {
+		byLang[page.Lang] = append(byLang[page.Lang], page)
+	}
+
+	for lang, entries := range byLang {
+		sort.Slice(entries, func(i, j int) bool {
+			return pageDate(entries[i]).After(pageDate(entries[j]))
+		})
+
+		feedEntries := entries
+		if cfg.Limit > 0 && len(feedEntries) > cfg.Limit {
+			feedEntries = feedEntries[:cfg.Limit]
+		}
+
+		suffix := ""
+		if lang != "" && lang != cfg.DefaultLanguage {
+			suffix = "." + lang
+		}
+
+		if err := writeAtom(filepath.Join(buildDir, "atom"+suffix+".xml"), cfg, feedEntries); err != nil {
+			return err
+		}
+		if err := writeRSS(filepath.Join(buildDir, "rss"+suffix+".xml"), cfg, feedEntries); err != nil {
+			return err
+		}
+		if err := writeSitemap(filepath.Join(buildDir, "sitemap"+suffix+".xml"), cfg, entries); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eligiblePages drops drafts and anything front matter excludes with `feed: false`.
+func eligiblePages(pages []site.Page) []site.Page {
+	var eligible []site.Page
+	for _, page := range pages {
+		if draft, ok := page.FrontMatter["draft"].(bool); ok && draft {
+			continue
+		}
+		if include, ok := page.FrontMatter["feed"].(bool); ok && !include {
+			continue
+		}
+		eligible = append(eligible, page)
+	}
+	return eligible
+}
+
+// pageDate reads the `date` front-matter key, which goldmark-meta hands
+// back as a time.Time when it parses as an unquoted YAML timestamp, or as
+// a plain string otherwise.
+func pageDate(page site.Page) time.Time {
+	switch v := page.FrontMatter["date"].(type) {
+	case time.Time:
+		return v
+	case string:
+		if t, err := time.Parse("2006-01-02", v); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func pageURL(cfg Config, page site.Page) string {
+	return strings.TrimRight(cfg.BaseURL, "/") + page.URL
+}
+
+func pageTitle(page site.Page) string {
+	title, _ := page.FrontMatter["title"].(string)
+	return title
+}
+
+func pageSummary(page site.Page) string {
+	summary, _ := page.FrontMatter["summary"].(string)
+	return summary
+}
+
+type atomFeed struct {
+	XMLName xml.Name   `xml:"feed"`
+	Xmlns   string     `xml:"xmlns,attr"`
+	Title   string     `xml:"title"`
+	ID      string     `xml:"id"`
+	Updated string     `xml:"updated"`
+	Author  atomAuthor `xml:"author"`
+	Link    atomLink   `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title   string   `xml:"title"`
+	ID      string   `xml:"id"`
+	Updated string   `xml:"updated"`
+	Summary string   `xml:"summary,omitempty"`
+	Link    atomLink `xml:"link"`
+}
+
+func writeAtom(path string, cfg Config, pages []site.Page) error {
+	feed := atomFeed{
+		Xmlns:   "http://www.w3.org/2005/Atom",
+		Title:   cfg.Title,
+		ID:      cfg.BaseURL,
+		Updated: time.Now().UTC().Format(time.RFC3339),
+		Author:  atomAuthor{Name: cfg.Author},
+		Link:    atomLink{Href: cfg.BaseURL},
+	}
+
+	for _, page := range pages {
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:   pageTitle(page),
+			ID:      pageURL(cfg, page),
+			Updated: pageDate(page).UTC().Format(time.RFC3339),
+			Summary: pageSummary(page),
+			Link:    atomLink{Href: pageURL(cfg, page)},
+		})
+	}
+
+	return writeXML(path, feed)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Language    string    `xml:"language,omitempty"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	GUID        string `xml:"guid"`
+	PubDate     string `xml:"pubDate"`
+	Description string `xml:"description,omitempty"`
+}
+
+func writeRSS(path string, cfg Config, pages []site.Page) error {
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       cfg.Title,
+			Link:        cfg.BaseURL,
+			Description: cfg.Title,
+			Language:    cfg.Language,
+		},
+	}
+
+	for _, page := range pages {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       pageTitle(page),
+			Link:        pageURL(cfg, page),
+			GUID:        pageURL(cfg, page),
+			PubDate:     pageDate(page).UTC().Format(time.RFC1123Z),
+			Description: pageSummary(page),
+		})
+	}
+
+	return writeXML(path, feed)
+}
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+func writeSitemap(path string, cfg Config, pages []site.Page) error {
+	set := sitemapURLSet{Xmlns: "http://www.sitemaps.org/schemas/sitemap/0.9"}
+
+	for _, page := range pages {
+		set.URLs = append(set.URLs, sitemapURL{
+			Loc:     pageURL(cfg, page),
+			LastMod: pageDate(page).UTC().Format("2006-01-02"),
+		})
+	}
+
+	return writeXML(path, set)
+}
+
+func writeXML(path string, v interface{}) error {
+	out, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal %q: %w", path, err)
+	}
+
+	content := append([]byte(xml.Header), out...)
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("write %q: %w", path, err)
+	}
+	return nil
+}
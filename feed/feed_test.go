@@ -0,0 +1,78 @@
+package feed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JoyenBenitto/giggle/site"
+)
+
+func TestEligiblePages(t *testing.T) {
+	pages := []site.Page{
+		{URL: "/a/", FrontMatter: map[string]interface{}{}},
+		{URL: "/draft/", FrontMatter: map[string]interface{}{"draft": true}},
+		{URL: "/no-feed/", FrontMatter: map[string]interface{}{"feed": false}},
+		{URL: "/explicit-feed/", FrontMatter: map[string]interface{}{"feed": true}},
+		{URL: "/explicit-not-draft/", FrontMatter: map[string]interface{}{"draft": false}},
+	}
+
+	got := eligiblePages(pages)
+
+	var urls []string
+	for _, p := range got {
+		urls = append(urls, p.URL)
+	}
+
+	want := []string{"/a/", "/explicit-feed/", "/explicit-not-draft/"}
+	if len(urls) != len(want) {
+		t.Fatalf("eligiblePages() = %v, want %v", urls, want)
+	}
+	for i := range want {
+		if urls[i] != want[i] {
+			t.Errorf("eligiblePages()[%d] = %q, want %q", i, urls[i], want[i])
+		}
+	}
+}
+
+func TestPageDate(t *testing.T) {
+	tests := []struct {
+		name string
+		date interface{}
+		want time.Time
+	}{
+		{
+			name: "time.Time value",
+			date: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "date string",
+			date: "2024-03-15",
+			want: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "unparseable string falls back to zero value",
+			date: "not a date",
+			want: time.Time{},
+		},
+		{
+			name: "missing date falls back to zero value",
+			date: nil,
+			want: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := site.Page{FrontMatter: map[string]interface{}{}}
+			if tt.date != nil {
+				page.FrontMatter["date"] = tt.date
+			}
+
+			got := pageDate(page)
+			if !got.Equal(tt.want) {
+				t.Errorf("pageDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
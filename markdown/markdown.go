@@ -0,0 +1,100 @@
+// Package markdown wraps goldmark into the single pluggable renderer the
+// rest of giggle converts markdown through.
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark-emoji"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	meta "github.com/yuin/goldmark-meta"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	gmhtml "github.com/yuin/goldmark/renderer/html"
+	"go.abhg.dev/goldmark/mermaid"
+)
+
+// Config controls which goldmark extensions are wired in for a given
+// render. Values come from the `markdown` section of config_yaml so sites
+// can turn extensions on/off without a rebuild.
+type Config struct {
+	GFM       bool
+	Emoji     bool
+	Mermaid   bool
+	Highlight bool
+}
+
+// ConfigFromYAML reads the optional `markdown` section of config_info and
+// fills in sensible defaults (everything on) for keys that are missing.
+func ConfigFromYAML(config_info map[string]interface{}) Config {
+	cfg := Config{GFM: true, Emoji: true, Mermaid: true, Highlight: true}
+
+	section, ok := config_info["markdown"].(map[interface{}]interface{})
+	if !ok {
+		return cfg
+	}
+
+	if v, ok := section["gfm"].(bool); ok {
+		cfg.GFM = v
+	}
+	if v, ok := section["emoji"].(bool); ok {
+		cfg.Emoji = v
+	}
+	if v, ok := section["mermaid"].(bool); ok {
+		cfg.Mermaid = v
+	}
+	if v, ok := section["highlight"].(bool); ok {
+		cfg.Highlight = v
+	}
+
+	return cfg
+}
+
+func newGoldmark(cfg Config) goldmark.Markdown {
+	extensions := []goldmark.Extender{meta.Meta}
+
+	if cfg.GFM {
+		extensions = append(extensions, extension.GFM)
+	}
+	if cfg.Emoji {
+		extensions = append(extensions, emoji.Emoji)
+	}
+	if cfg.Mermaid {
+		extensions = append(extensions, &mermaid.Extender{})
+	}
+	if cfg.Highlight {
+		extensions = append(extensions, highlighting.NewHighlighting(
+			highlighting.WithStyle("monokai"),
+		))
+	}
+
+	return goldmark.New(
+		goldmark.WithExtensions(extensions...),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+		goldmark.WithRendererOptions(gmhtml.WithUnsafe()),
+	)
+}
+
+// Render renders a markdown source file and returns the rendered HTML
+// alongside the parsed front matter (title, date, tags, draft, slug,
+// summary, ...) so page generation can use it for templates, listings and
+// feeds. path is only used to give parser errors file context.
+func Render(path string, md []byte, config_info map[string]interface{}) ([]byte, map[string]interface{}, error) {
+	cfg := ConfigFromYAML(config_info)
+	gm := newGoldmark(cfg)
+
+	ctx := parser.NewContext()
+	var buf bytes.Buffer
+	if err := gm.Convert(md, &buf, parser.WithContext(ctx)); err != nil {
+		return nil, nil, fmt.Errorf("render markdown %q: %w", path, err)
+	}
+
+	frontMatter, err := meta.TryGet(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse front matter %q: %w", path, err)
+	}
+
+	return buf.Bytes(), frontMatter, nil
+}
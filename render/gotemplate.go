@@ -0,0 +1,42 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"strings"
+)
+
+// GoTemplateRenderer renders pages with the standard library's html/template.
+// Every *.html file in templateFiles is registered by its base name without
+// extension, so a layout under templates/layouts/post.html can reference a
+// partial under partials/footer.html as {{template "footer" .}}.
+type GoTemplateRenderer struct {
+	tmpl *template.Template
+}
+
+// NewGoTemplateRenderer parses every *.html entry in templateFiles.
+func NewGoTemplateRenderer(templateFiles map[string][]byte) (*GoTemplateRenderer, error) {
+	root := template.New("root")
+
+	for path, content := range templateFiles {
+		if filepath.Ext(path) != ".html" {
+			continue
+		}
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if _, err := root.New(name).Parse(string(content)); err != nil {
+			return nil, fmt.Errorf("parse template %q: %w", path, err)
+		}
+	}
+
+	return &GoTemplateRenderer{tmpl: root}, nil
+}
+
+func (r *GoTemplateRenderer) Render(name string, ctx any) (string, error) {
+	var buf bytes.Buffer
+	if err := r.tmpl.ExecuteTemplate(&buf, name, ctx); err != nil {
+		return "", fmt.Errorf("render template %q: %w", name, err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,75 @@
+package render
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aymerick/raymond"
+)
+
+// HandlebarsRenderer renders pages with Mustache-style Handlebars templates
+// for theme authors who prefer that syntax over html/template.
+type HandlebarsRenderer struct {
+	templates map[string]*raymond.Template
+}
+
+// NewHandlebarsRenderer parses every *.hbs entry in templateFiles. Entries
+// under a "partials" path segment (see theme.Theme.Templates) are registered
+// on each top-level template individually (rather than raymond's
+// process-global registry, which panics on re-registration and would crash
+// `giggle serve` on a second rebuild).
+func NewHandlebarsRenderer(templateFiles map[string][]byte) (*HandlebarsRenderer, error) {
+	templates := make(map[string]*raymond.Template)
+	partials := make(map[string]*raymond.Template)
+
+	for path, content := range templateFiles {
+		if filepath.Ext(path) != ".hbs" {
+			continue
+		}
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+		tpl, err := raymond.Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("parse template %q: %w", path, err)
+		}
+
+		if isPartial(path) {
+			partials[name] = tpl
+			continue
+		}
+		templates[name] = tpl
+	}
+
+	for _, tpl := range templates {
+		for partialName, partialTpl := range partials {
+			tpl.RegisterPartialTemplate(partialName, partialTpl)
+		}
+	}
+
+	return &HandlebarsRenderer{templates: templates}, nil
+}
+
+// isPartial reports whether path (relative to a theme's merged template
+// set) falls under a "partials" directory, at any depth.
+func isPartial(path string) bool {
+	for _, segment := range strings.Split(filepath.ToSlash(filepath.Dir(path)), "/") {
+		if segment == "partials" {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *HandlebarsRenderer) Render(name string, ctx any) (string, error) {
+	tpl, ok := r.templates[name]
+	if !ok {
+		return "", fmt.Errorf("handlebars template %q not found", name)
+	}
+
+	out, err := tpl.Exec(ctx)
+	if err != nil {
+		return "", fmt.Errorf("render handlebars template %q: %w", name, err)
+	}
+	return out, nil
+}
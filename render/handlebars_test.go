@@ -0,0 +1,43 @@
+package render
+
+import "testing"
+
+func TestHandlebarsRendererResolvesTopLevelPartial(t *testing.T) {
+	templateFiles := map[string][]byte{
+		"layout.hbs":          []byte("before {{> footer}} after"),
+		"partials/footer.hbs": []byte("footer content"),
+	}
+
+	r, err := NewHandlebarsRenderer(templateFiles)
+	if err != nil {
+		t.Fatalf("NewHandlebarsRenderer: %v", err)
+	}
+
+	out, err := r.Render("layout", nil)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	want := "before footer content after"
+	if out != want {
+		t.Errorf("Render() = %q, want %q", out, want)
+	}
+}
+
+func TestIsPartial(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"partials/footer.hbs", true},
+		{"partials/sub/footer.hbs", true},
+		{"layout.hbs", false},
+		{"layouts/post.hbs", false},
+	}
+
+	for _, tt := range tests {
+		if got := isPartial(tt.path); got != tt.want {
+			t.Errorf("isPartial(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
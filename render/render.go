@@ -0,0 +1,27 @@
+// Package render abstracts over the template engine a theme uses. The site
+// package renders every Page through a Renderer without caring whether the
+// underlying engine is Go's html/template or Handlebars.
+package render
+
+import "fmt"
+
+// Renderer renders a named template against ctx and returns the resulting
+// markup.
+type Renderer interface {
+	Render(name string, ctx any) (string, error)
+}
+
+// New builds the Renderer selected by a theme's `engine` key ("gotmpl" or
+// "handlebars"). templateFiles is the theme's merged template set, keyed by
+// path relative to its templates/partials roots (see theme.Theme.Templates).
+// An empty engine defaults to gotmpl.
+func New(engine string, templateFiles map[string][]byte) (Renderer, error) {
+	switch engine {
+	case "", "gotmpl":
+		return NewGoTemplateRenderer(templateFiles)
+	case "handlebars":
+		return NewHandlebarsRenderer(templateFiles)
+	default:
+		return nil, fmt.Errorf("unknown template engine %q", engine)
+	}
+}
@@ -0,0 +1,250 @@
+package site
+
+import (
+	"fmt"
+	"html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/JoyenBenitto/giggle/markdown"
+	"github.com/JoyenBenitto/giggle/render"
+)
+
+// PageGenerator walks a content directory, converts every markdown file to
+// HTML and renders it through a named template via Renderer. The full set
+// of built Pages is kept around so listing/index pages can range over it.
+type PageGenerator struct {
+	Renderer   render.Renderer
+	ContentDir string
+	BuildDir   string
+
+	Pages []Page
+}
+
+// NewPageGenerator wires up a PageGenerator that renders through renderer.
+func NewPageGenerator(renderer render.Renderer, contentDir, buildDir string) *PageGenerator {
+	return &PageGenerator{
+		Renderer:   renderer,
+		ContentDir: contentDir,
+		BuildDir:   buildDir,
+	}
+}
+
+// i18nConfig is read from the `default_language` and `languages` keys of
+// config_yaml and controls how content files map to per-language routes.
+type i18nConfig struct {
+	DefaultLanguage string
+	Languages       map[string]bool
+}
+
+func i18nConfigFromYAML(config_info map[string]interface{}) i18nConfig {
+	cfg := i18nConfig{DefaultLanguage: "en", Languages: map[string]bool{}}
+
+	if v, ok := config_info["default_language"].(string); ok && v != "" {
+		cfg.DefaultLanguage = v
+	}
+	if section, ok := config_info["languages"].(map[interface{}]interface{}); ok {
+		for k := range section {
+			if code, ok := k.(string); ok {
+				cfg.Languages[code] = true
+			}
+		}
+	}
+	cfg.Languages[cfg.DefaultLanguage] = true
+
+	return cfg
+}
+
+// Build walks ContentDir, parses every markdown file's front matter and
+// body, then renders each Page through its chosen template. config_info is
+// forwarded to the markdown renderer unchanged (it carries the per-extension
+// toggles from config_yaml).
+func (g *PageGenerator) Build(config_info map[string]interface{}) error {
+	i18n := i18nConfigFromYAML(config_info)
+
+	var logicalKeys []string
+	g.Pages = nil
+
+	err := filepath.WalkDir(g.ContentDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".md" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(g.ContentDir, path)
+		if err != nil {
+			return fmt.Errorf("relativize %q: %w", path, err)
+		}
+
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %q: %w", path, err)
+		}
+
+		html, frontMatter, err := markdown.Render(path, raw, config_info)
+		if err != nil {
+			return err
+		}
+
+		dir := filepath.Dir(rel)
+		logicalName, lang := splitLanguageSuffix(filepath.Base(rel), i18n.Languages)
+		if lang == "" {
+			lang = i18n.DefaultLanguage
+		}
+
+		outputPath, url := routeFor(dir, logicalName, lang, i18n.DefaultLanguage)
+		tmplName, _ := frontMatter["template"].(string)
+		if tmplName == "" {
+			tmplName = "layout"
+		}
+
+		g.Pages = append(g.Pages, Page{
+			SourcePath:  path,
+			OutputPath:  outputPath,
+			URL:         url,
+			Template:    tmplName,
+			FrontMatter: frontMatter,
+			Content:     template.HTML(html),
+			Lang:        lang,
+		})
+		logicalKeys = append(logicalKeys, filepath.Join(dir, logicalName))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("walk content dir %q: %w", g.ContentDir, err)
+	}
+
+	g.linkTranslations(logicalKeys)
+
+	for _, page := range g.Pages {
+		if err := g.renderPage(page); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// linkTranslations groups pages that share a logical key (the same content
+// path with its language suffix stripped) and fills in each one's
+// Translations with its siblings.
+func (g *PageGenerator) linkTranslations(logicalKeys []string) {
+	groups := make(map[string][]int)
+	for i, key := range logicalKeys {
+		groups[key] = append(groups[key], i)
+	}
+
+	for _, indices := range groups {
+		if len(indices) < 2 {
+			continue
+		}
+		for _, i := range indices {
+			for _, j := range indices {
+				if i == j {
+					continue
+				}
+				sibling := g.Pages[j]
+				g.Pages[i].Translations = append(g.Pages[i].Translations, Translation{
+					Lang:  sibling.Lang,
+					URL:   sibling.URL,
+					Title: pageTitle(sibling),
+				})
+			}
+		}
+	}
+}
+
+func pageTitle(page Page) string {
+	title, _ := page.FrontMatter["title"].(string)
+	return title
+}
+
+// splitLanguageSuffix recognizes translation filenames like "post.de.md":
+// it returns the logical name ("post") and the language code ("de") when
+// the second-to-last dot-separated segment is a known language. A plain
+// "post.md" returns ("post", "").
+func splitLanguageSuffix(base string, languages map[string]bool) (logicalName, lang string) {
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+	parts := strings.Split(name, ".")
+	if len(parts) >= 2 && languages[parts[len(parts)-1]] {
+		return strings.Join(parts[:len(parts)-1], "."), parts[len(parts)-1]
+	}
+	return name, ""
+}
+
+// renderPage is a thin caller of the selected Renderer: it builds the page
+// context, injects hreflang tags for any translations, and writes whatever
+// markup comes back.
+func (g *PageGenerator) renderPage(page Page) error {
+	data := struct {
+		Page  Page
+		Pages []Page
+	}{Page: page, Pages: g.Pages}
+
+	rendered, err := g.Renderer.Render(page.Template, data)
+	if err != nil {
+		return fmt.Errorf("render %q: %w", page.SourcePath, err)
+	}
+
+	rendered = injectHreflang(rendered, page)
+
+	outPath := filepath.Join(g.BuildDir, page.OutputPath)
+	if err := os.MkdirAll(filepath.Dir(outPath), os.ModePerm); err != nil {
+		return fmt.Errorf("create output dir for %q: %w", page.SourcePath, err)
+	}
+
+	if err := os.WriteFile(outPath, []byte(rendered), 0644); err != nil {
+		return fmt.Errorf("write %q: %w", outPath, err)
+	}
+
+	return nil
+}
+
+// injectHreflang adds <link rel="alternate" hreflang="..."> tags pointing
+// at page and every one of its translations, just before </head>.
+func injectHreflang(htmlStr string, page Page) string {
+	if len(page.Translations) == 0 {
+		return htmlStr
+	}
+
+	var tags strings.Builder
+	fmt.Fprintf(&tags, "<link rel=\"alternate\" hreflang=%q href=%q>\n", page.Lang, page.URL)
+	for _, t := range page.Translations {
+		fmt.Fprintf(&tags, "<link rel=\"alternate\" hreflang=%q href=%q>\n", t.Lang, t.URL)
+	}
+
+	if idx := strings.Index(htmlStr, "</head>"); idx >= 0 {
+		return htmlStr[:idx] + tags.String() + htmlStr[idx:]
+	}
+	return tags.String() + htmlStr
+}
+
+// routeFor maps a logical content path (directory plus markdown base name,
+// language suffix already stripped) to its build-relative output path and
+// site URL. _index becomes index.html in the same directory; foo becomes
+// foo/index.html for pretty URLs. Non-default languages are nested under
+// their language code, e.g. "de/foo/index.html".
+func routeFor(dir, name, lang, defaultLang string) (outputPath string, url string) {
+	var base string
+	switch {
+	case name == "_index" && dir == ".":
+		base = "index.html"
+	case name == "_index":
+		base = filepath.Join(dir, "index.html")
+	case dir == ".":
+		base = filepath.Join(name, "index.html")
+	default:
+		base = filepath.Join(dir, name, "index.html")
+	}
+
+	if lang != "" && lang != defaultLang {
+		base = filepath.Join(lang, base)
+	}
+
+	url = "/" + filepath.ToSlash(strings.TrimSuffix(base, "index.html"))
+	return base, url
+}
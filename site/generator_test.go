@@ -0,0 +1,130 @@
+package site
+
+import "testing"
+
+func TestRouteFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		dir        string
+		pageName   string
+		lang       string
+		defaultLng string
+		wantOutput string
+		wantURL    string
+	}{
+		{
+			name:       "root index",
+			dir:        ".",
+			pageName:   "_index",
+			lang:       "en",
+			defaultLng: "en",
+			wantOutput: "index.html",
+			wantURL:    "/",
+		},
+		{
+			name:       "section index",
+			dir:        "posts",
+			pageName:   "_index",
+			lang:       "en",
+			defaultLng: "en",
+			wantOutput: "posts/index.html",
+			wantURL:    "/posts/",
+		},
+		{
+			name:       "pretty url at root",
+			dir:        ".",
+			pageName:   "hello",
+			lang:       "en",
+			defaultLng: "en",
+			wantOutput: "hello/index.html",
+			wantURL:    "/hello/",
+		},
+		{
+			name:       "pretty url nested",
+			dir:        "posts",
+			pageName:   "hello",
+			lang:       "en",
+			defaultLng: "en",
+			wantOutput: "posts/hello/index.html",
+			wantURL:    "/posts/hello/",
+		},
+		{
+			name:       "non-default language nests under its code",
+			dir:        "posts",
+			pageName:   "hello",
+			lang:       "de",
+			defaultLng: "en",
+			wantOutput: "de/posts/hello/index.html",
+			wantURL:    "/de/posts/hello/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotOutput, gotURL := routeFor(tt.dir, tt.pageName, tt.lang, tt.defaultLng)
+			if gotOutput != tt.wantOutput {
+				t.Errorf("outputPath = %q, want %q", gotOutput, tt.wantOutput)
+			}
+			if gotURL != tt.wantURL {
+				t.Errorf("url = %q, want %q", gotURL, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestSplitLanguageSuffix(t *testing.T) {
+	languages := map[string]bool{"en": true, "de": true, "fr": true}
+
+	tests := []struct {
+		name            string
+		base            string
+		wantLogicalName string
+		wantLang        string
+	}{
+		{"no suffix", "post.md", "post", ""},
+		{"known language suffix", "post.de.md", "post", "de"},
+		{"another known language suffix", "post.fr.md", "post", "fr"},
+		{"unknown suffix is kept as part of the name", "post.draft.md", "post.draft", ""},
+		{"index with language suffix", "_index.de.md", "_index", "de"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotName, gotLang := splitLanguageSuffix(tt.base, languages)
+			if gotName != tt.wantLogicalName {
+				t.Errorf("logicalName = %q, want %q", gotName, tt.wantLogicalName)
+			}
+			if gotLang != tt.wantLang {
+				t.Errorf("lang = %q, want %q", gotLang, tt.wantLang)
+			}
+		})
+	}
+}
+
+func TestPageGeneratorLinkTranslations(t *testing.T) {
+	g := &PageGenerator{
+		Pages: []Page{
+			{URL: "/hello/", Lang: "en", FrontMatter: map[string]interface{}{"title": "Hello"}},
+			{URL: "/de/hello/", Lang: "de", FrontMatter: map[string]interface{}{"title": "Hallo"}},
+			{URL: "/about/", Lang: "en", FrontMatter: map[string]interface{}{"title": "About"}},
+		},
+	}
+	logicalKeys := []string{"hello", "hello", "about"}
+
+	g.linkTranslations(logicalKeys)
+
+	if len(g.Pages[2].Translations) != 0 {
+		t.Errorf("about page should have no translations, got %v", g.Pages[2].Translations)
+	}
+
+	if len(g.Pages[0].Translations) != 1 || g.Pages[0].Translations[0].Lang != "de" {
+		t.Errorf("hello/en translations = %v, want one de translation", g.Pages[0].Translations)
+	}
+	if g.Pages[0].Translations[0].URL != "/de/hello/" || g.Pages[0].Translations[0].Title != "Hallo" {
+		t.Errorf("hello/en translation = %+v, want URL /de/hello/ and title Hallo", g.Pages[0].Translations[0])
+	}
+
+	if len(g.Pages[1].Translations) != 1 || g.Pages[1].Translations[0].Lang != "en" {
+		t.Errorf("hello/de translations = %v, want one en translation", g.Pages[1].Translations)
+	}
+}
@@ -0,0 +1,38 @@
+// Package site walks a content tree and renders it into a built site,
+// replacing the old single-file index_html_generator with a general
+// PageGenerator that understands front-matter-driven routing and templates.
+package site
+
+import "html/template"
+
+// Page is one rendered markdown source file. It is exposed to templates so
+// that index/listing pages can iterate the rest of the site.
+type Page struct {
+	// SourcePath is the markdown file this page was generated from.
+	SourcePath string
+	// OutputPath is relative to the build directory, e.g. "posts/hello/index.html".
+	OutputPath string
+	// URL is the site-relative URL a browser would request, e.g. "/posts/hello/".
+	URL string
+	// Template is the name (without extension) picked from front matter,
+	// falling back to "layout" when the page doesn't set one.
+	Template string
+	// FrontMatter is the parsed YAML front matter (title, date, tags, draft, slug, summary, ...).
+	FrontMatter map[string]interface{}
+	// Content is the rendered markdown body, safe to embed directly in a template.
+	Content template.HTML
+	// Lang is this page's language code, resolved from its filename
+	// (post.de.md -> "de") or the site's default_language if unsuffixed.
+	Lang string
+	// Translations lists the sibling pages that are the same logical page
+	// in other languages, so templates can render a language switcher.
+	Translations []Translation
+}
+
+// Translation is a lightweight pointer to a sibling Page in another
+// language, used instead of embedding the full Page to avoid cycles.
+type Translation struct {
+	Lang  string
+	URL   string
+	Title string
+}
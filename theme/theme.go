@@ -0,0 +1,162 @@
+// Package theme loads a giggle theme: a directory of theme.yaml, templates/,
+// partials/ and static/, optionally inheriting from a parent theme. A
+// default theme ships embedded in the binary so `giggle build` works with
+// zero files on disk.
+package theme
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed all:default
+var embeddedFS embed.FS
+
+// defaultThemeName is the magic parent/theme_yaml value that always
+// resolves to the theme compiled into the binary.
+const defaultThemeName = "default"
+
+// Theme is a loaded theme, possibly inheriting templates, partials and
+// static assets from a parent theme.
+type Theme struct {
+	Name   string
+	Engine string
+
+	dir    string
+	fsys   fs.FS
+	parent *Theme
+}
+
+// Load reads the theme at dir (a directory containing theme.yaml). An
+// empty dir, or a dir that doesn't exist on disk, loads the embedded
+// default theme, so `giggle build` works with zero files on disk.
+func Load(dir string) (*Theme, error) {
+	if dir == "" || dir == defaultThemeName {
+		return loadEmbeddedDefault()
+	}
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return loadEmbeddedDefault()
+	}
+
+	return load(os.DirFS(dir), dir)
+}
+
+func loadEmbeddedDefault() (*Theme, error) {
+	sub, err := fs.Sub(embeddedFS, defaultThemeName)
+	if err != nil {
+		return nil, fmt.Errorf("open embedded default theme: %w", err)
+	}
+	return load(sub, "")
+}
+
+func load(fsys fs.FS, dir string) (*Theme, error) {
+	data, err := fs.ReadFile(fsys, "theme.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("read theme.yaml in %q: %w", dir, err)
+	}
+
+	meta := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("parse theme.yaml in %q: %w", dir, err)
+	}
+
+	t := &Theme{fsys: fsys, dir: dir}
+	if v, ok := meta["name"].(string); ok {
+		t.Name = v
+	}
+	if v, ok := meta["engine"].(string); ok {
+		t.Engine = v
+	}
+
+	if parentName, ok := meta["parent"].(string); ok && parentName != "" {
+		parentDir := parentName
+		if parentName != defaultThemeName && dir != "" {
+			parentDir = filepath.Join(filepath.Dir(dir), parentName)
+		}
+		parent, err := Load(parentDir)
+		if err != nil {
+			return nil, fmt.Errorf("load parent theme %q: %w", parentName, err)
+		}
+		t.parent = parent
+	}
+
+	return t, nil
+}
+
+// Templates returns every file under templates/ and partials/, keyed by
+// path relative to its own root (e.g. "post.html", "layouts/list.html").
+// Entries under partials/ keep a leading "partials/" path segment so
+// renderers can tell a partial apart from a top-level template even after
+// the two roots are merged into one map. Entries from a parent theme are
+// included first so a child theme's file of the same name overrides it:
+// lookup falls back to the parent only when the child doesn't declare that
+// file itself.
+func (t *Theme) Templates() (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	for _, root := range []string{"templates", "partials"} {
+		tree, err := t.collectTree(root)
+		if err != nil {
+			return nil, err
+		}
+		for name, content := range tree {
+			if root == "partials" {
+				name = filepath.Join("partials", name)
+			}
+			files[name] = content
+		}
+	}
+	return files, nil
+}
+
+// Static returns every file under static/, keyed by path relative to
+// static/, with the same parent-then-child override behaviour as Templates.
+func (t *Theme) Static() (map[string][]byte, error) {
+	return t.collectTree("static")
+}
+
+// collectTree walks root within t's parent chain (parent first, self
+// last) so that a theme's own files win over anything inherited.
+func (t *Theme) collectTree(root string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	if t.parent != nil {
+		parentFiles, err := t.parent.collectTree(root)
+		if err != nil {
+			return nil, err
+		}
+		for name, content := range parentFiles {
+			files[name] = content
+		}
+	}
+
+	err := fs.WalkDir(t.fsys, root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		content, err := fs.ReadFile(t.fsys, path)
+		if err != nil {
+			return err
+		}
+		files[rel] = content
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("walk %q in theme %q: %w", root, t.Name, err)
+	}
+
+	return files, nil
+}
@@ -0,0 +1,112 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeFile creates path (and its parent directories) with the given content.
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		t.Fatalf("MkdirAll(%q): %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile(%q): %v", path, err)
+	}
+}
+
+func TestLoadParentOverride(t *testing.T) {
+	root := t.TempDir()
+
+	parentDir := filepath.Join(root, "parent")
+	writeFile(t, filepath.Join(parentDir, "theme.yaml"), "name: parent\nengine: gotmpl\n")
+	writeFile(t, filepath.Join(parentDir, "templates", "layout.html"), "parent layout")
+	writeFile(t, filepath.Join(parentDir, "templates", "post.html"), "parent post")
+	writeFile(t, filepath.Join(parentDir, "static", "style.css"), "parent css")
+
+	childDir := filepath.Join(root, "child")
+	writeFile(t, filepath.Join(childDir, "theme.yaml"), "name: child\nengine: gotmpl\nparent: parent\n")
+	writeFile(t, filepath.Join(childDir, "templates", "post.html"), "child post")
+	writeFile(t, filepath.Join(childDir, "static", "style.css"), "child css")
+
+	th, err := Load(childDir)
+	if err != nil {
+		t.Fatalf("Load(childDir): %v", err)
+	}
+
+	templates, err := th.Templates()
+	if err != nil {
+		t.Fatalf("Templates(): %v", err)
+	}
+
+	if got := string(templates["layout.html"]); got != "parent layout" {
+		t.Errorf(`templates["layout.html"] = %q, want "parent layout" (inherited)`, got)
+	}
+	if got := string(templates["post.html"]); got != "child post" {
+		t.Errorf(`templates["post.html"] = %q, want "child post" (child override)`, got)
+	}
+
+	static, err := th.Static()
+	if err != nil {
+		t.Fatalf("Static(): %v", err)
+	}
+	if got := string(static["style.css"]); got != "child css" {
+		t.Errorf(`static["style.css"] = %q, want "child css" (child override)`, got)
+	}
+}
+
+func TestTemplatesKeepsPartialsPrefix(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "theme.yaml"), "name: test\nengine: handlebars\n")
+	writeFile(t, filepath.Join(dir, "templates", "layout.hbs"), "layout")
+	writeFile(t, filepath.Join(dir, "partials", "footer.hbs"), "footer")
+
+	th, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load(dir): %v", err)
+	}
+
+	templates, err := th.Templates()
+	if err != nil {
+		t.Fatalf("Templates(): %v", err)
+	}
+
+	if _, ok := templates["layout.hbs"]; !ok {
+		t.Errorf("templates missing top-level layout.hbs, got %v", templates)
+	}
+	if _, ok := templates["partials/footer.hbs"]; !ok {
+		t.Errorf(`templates missing "partials/footer.hbs" key, got %v`, templates)
+	}
+}
+
+func TestLoadFallsBackToEmbeddedWhenDirMissing(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "does-not-exist")
+
+	th, err := Load(missing)
+	if err != nil {
+		t.Fatalf("Load(missing dir): %v", err)
+	}
+	if th.Name != "default" {
+		t.Errorf("Name = %q, want %q", th.Name, "default")
+	}
+}
+
+func TestLoadEmbeddedDefault(t *testing.T) {
+	th, err := Load("")
+	if err != nil {
+		t.Fatalf("Load(\"\"): %v", err)
+	}
+	if th.Name != "default" {
+		t.Errorf("Name = %q, want %q", th.Name, "default")
+	}
+
+	templates, err := th.Templates()
+	if err != nil {
+		t.Fatalf("Templates(): %v", err)
+	}
+	if _, ok := templates["layout.html"]; !ok {
+		t.Errorf("embedded default theme missing templates/layout.html, got %v", templates)
+	}
+}